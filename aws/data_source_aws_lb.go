@@ -2,10 +2,13 @@ package aws
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/aws/aws-sdk-go/service/wafv2"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
@@ -150,11 +153,366 @@ func dataSourceAwsLb() *schema.Resource {
 				Computed: true,
 			},
 
+			"web_acl_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"web_acl_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"web_acl_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"shield_protection": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"protection_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"protection_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"include_edge_security": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"connection_logs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"desync_mitigation_mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"preserve_host_header": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"xff_header_processing_mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"client_keep_alive": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"waf_fail_open": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"routing": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"http": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"x_amzn_tls_version_and_cipher_suite": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"enabled": {
+													Type:     schema.TypeBool,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"dns_record": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"client_routing_policy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"attributes": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"listeners": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ssl_policy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"certificate_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default_action_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"target_groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"health_check_protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"health_check_port": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"health_check_path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"targets": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"port": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"az": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"state": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"reason": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"include_listeners": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"include_target_groups": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"include_target_health": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"most_recent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"filter": lbFilterSchema(),
+
 			"tags": tagsSchema(),
 		},
 	}
 }
 
+// lbFilterSchema returns the schema for the repeatable `filter` block shared
+// by data.aws_lb and data.aws_lbs, following the filter block style used by
+// data sources like aws_ami_ids and aws_ebs_snapshot_ids.
+func lbFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"values": {
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+// lbDescribeFilter is the expanded form of a single `filter` block.
+type lbDescribeFilter struct {
+	Name   string
+	Values []string
+}
+
+func expandLbDescribeFilters(set *schema.Set) []*lbDescribeFilter {
+	if set == nil {
+		return nil
+	}
+
+	filters := make([]*lbDescribeFilter, 0, set.Len())
+
+	for _, v := range set.List() {
+		m := v.(map[string]interface{})
+
+		values := make([]string, 0)
+		for _, value := range m["values"].(*schema.Set).List() {
+			values = append(values, value.(string))
+		}
+
+		filters = append(filters, &lbDescribeFilter{
+			Name:   m["name"].(string),
+			Values: values,
+		})
+	}
+
+	return filters
+}
+
+// lbMatchesFilters evaluates the client-side attribute filters supported by
+// data.aws_lb and data.aws_lbs. The ELBv2 DescribeLoadBalancers API has no
+// server-side filtering, so matching is done against the fields already
+// returned for each load balancer.
+func lbMatchesFilters(lb *elbv2.LoadBalancer, filters []*lbDescribeFilter) (bool, error) {
+	for _, f := range filters {
+		var actual string
+
+		switch f.Name {
+		case "vpc_id":
+			actual = aws.StringValue(lb.VpcId)
+		case "scheme":
+			actual = aws.StringValue(lb.Scheme)
+		case "type":
+			actual = aws.StringValue(lb.Type)
+		case "ip_address_type":
+			actual = aws.StringValue(lb.IpAddressType)
+		case "state.code":
+			if lb.State != nil {
+				actual = aws.StringValue(lb.State.Code)
+			}
+		default:
+			return false, fmt.Errorf("unsupported filter name: %s", f.Name)
+		}
+
+		if !stringSliceContainsValue(f.Values, actual) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func stringSliceContainsValue(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
+
 func dataSourceAwsLbRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).elbv2conn
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
@@ -210,6 +568,31 @@ func dataSourceAwsLbRead(d *schema.ResourceData, meta interface{}) error {
 		results = loadBalancers
 	}
 
+	if filters := expandLbDescribeFilters(d.Get("filter").(*schema.Set)); len(filters) > 0 {
+		var filtered []*elbv2.LoadBalancer
+
+		for _, loadBalancer := range results {
+			matches, err := lbMatchesFilters(loadBalancer, filters)
+			if err != nil {
+				return err
+			}
+
+			if matches {
+				filtered = append(filtered, loadBalancer)
+			}
+		}
+
+		results = filtered
+	}
+
+	if len(results) > 1 && d.Get("most_recent").(bool) {
+		sort.Slice(results, func(i, j int) bool {
+			return aws.TimeValue(results[i].CreatedTime).After(aws.TimeValue(results[j].CreatedTime))
+		})
+
+		results = results[:1]
+	}
+
 	if len(results) != 1 {
 		return fmt.Errorf("Search returned %d results, please revise so only one is returned", len(results))
 	}
@@ -261,7 +644,25 @@ func dataSourceAwsLbRead(d *schema.ResourceData, meta interface{}) error {
 		"prefix":  "",
 	}
 
+	connectionLogMap := map[string]interface{}{
+		"bucket":  "",
+		"enabled": false,
+		"prefix":  "",
+	}
+
+	tlsVersionAndCipherSuiteMap := map[string]interface{}{
+		"enabled": false,
+	}
+
+	dnsRecordMap := map[string]interface{}{
+		"client_routing_policy": "",
+	}
+
+	rawAttributes := make(map[string]interface{}, len(attributesResp.Attributes))
+
 	for _, attr := range attributesResp.Attributes {
+		rawAttributes[aws.StringValue(attr.Key)] = aws.StringValue(attr.Value)
+
 		switch aws.StringValue(attr.Key) {
 		case "access_logs.s3.enabled":
 			accessLogMap["enabled"] = aws.StringValue(attr.Value) == "true"
@@ -269,6 +670,12 @@ func dataSourceAwsLbRead(d *schema.ResourceData, meta interface{}) error {
 			accessLogMap["bucket"] = aws.StringValue(attr.Value)
 		case "access_logs.s3.prefix":
 			accessLogMap["prefix"] = aws.StringValue(attr.Value)
+		case "connection_logs.s3.enabled":
+			connectionLogMap["enabled"] = aws.StringValue(attr.Value) == "true"
+		case "connection_logs.s3.bucket":
+			connectionLogMap["bucket"] = aws.StringValue(attr.Value)
+		case "connection_logs.s3.prefix":
+			connectionLogMap["prefix"] = aws.StringValue(attr.Value)
 		case "idle_timeout.timeout_seconds":
 			timeout, err := strconv.Atoi(aws.StringValue(attr.Value))
 			if err != nil {
@@ -287,6 +694,24 @@ func dataSourceAwsLbRead(d *schema.ResourceData, meta interface{}) error {
 		case "load_balancing.cross_zone.enabled":
 			crossZoneLbEnabled := aws.StringValue(attr.Value) == "true"
 			d.Set("enable_cross_zone_load_balancing", crossZoneLbEnabled)
+		case "routing.http.desync_mitigation_mode":
+			d.Set("desync_mitigation_mode", aws.StringValue(attr.Value))
+		case "routing.http.preserve_host_header.enabled":
+			d.Set("preserve_host_header", aws.StringValue(attr.Value) == "true")
+		case "routing.http.xff_header_processing.mode":
+			d.Set("xff_header_processing_mode", aws.StringValue(attr.Value))
+		case "client_keep_alive.seconds":
+			clientKeepAlive, err := strconv.Atoi(aws.StringValue(attr.Value))
+			if err != nil {
+				return fmt.Errorf("error parsing client_keep_alive.seconds: %w", err)
+			}
+			d.Set("client_keep_alive", clientKeepAlive)
+		case "waf.fail_open.enabled":
+			d.Set("waf_fail_open", aws.StringValue(attr.Value) == "true")
+		case "routing.http.x_amzn_tls_version_and_cipher_suite.enabled":
+			tlsVersionAndCipherSuiteMap["enabled"] = aws.StringValue(attr.Value) == "true"
+		case "dns_record.client_routing_policy":
+			dnsRecordMap["client_routing_policy"] = aws.StringValue(attr.Value)
 		}
 	}
 
@@ -294,5 +719,296 @@ func dataSourceAwsLbRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting access_logs: %w", err)
 	}
 
+	if err := d.Set("connection_logs", []interface{}{connectionLogMap}); err != nil {
+		return fmt.Errorf("error setting connection_logs: %w", err)
+	}
+
+	httpRoutingMap := map[string]interface{}{
+		"x_amzn_tls_version_and_cipher_suite": []interface{}{tlsVersionAndCipherSuiteMap},
+	}
+
+	routingMap := map[string]interface{}{
+		"http": []interface{}{httpRoutingMap},
+	}
+
+	if err := d.Set("routing", []interface{}{routingMap}); err != nil {
+		return fmt.Errorf("error setting routing: %w", err)
+	}
+
+	if err := d.Set("dns_record", []interface{}{dnsRecordMap}); err != nil {
+		return fmt.Errorf("error setting dns_record: %w", err)
+	}
+
+	if err := d.Set("attributes", rawAttributes); err != nil {
+		return fmt.Errorf("error setting attributes: %w", err)
+	}
+
+	if d.Get("include_edge_security").(bool) {
+		if err := dataSourceAwsLbSetWebAcl(d, meta, lb); err != nil {
+			return err
+		}
+
+		if err := dataSourceAwsLbSetShieldProtection(d, meta, lb); err != nil {
+			return err
+		}
+	} else {
+		d.Set("web_acl_arn", "")
+		d.Set("web_acl_id", "")
+		d.Set("web_acl_name", "")
+
+		if err := d.Set("shield_protection", []interface{}{}); err != nil {
+			return fmt.Errorf("error setting shield_protection: %w", err)
+		}
+	}
+
+	if d.Get("include_listeners").(bool) {
+		if err := dataSourceAwsLbSetListeners(d, conn); err != nil {
+			return err
+		}
+	} else if err := d.Set("listeners", []interface{}{}); err != nil {
+		return fmt.Errorf("error setting listeners: %w", err)
+	}
+
+	if d.Get("include_target_groups").(bool) {
+		if err := dataSourceAwsLbSetTargetGroups(d, conn); err != nil {
+			return err
+		}
+	} else if err := d.Set("target_groups", []interface{}{}); err != nil {
+		return fmt.Errorf("error setting target_groups: %w", err)
+	}
+
+	return nil
+}
+
+// dataSourceAwsLbSetListeners populates the computed listeners[] block by
+// describing every listener attached to the load balancer.
+func dataSourceAwsLbSetListeners(d *schema.ResourceData, conn *elbv2.ELBV2) error {
+	var listeners []interface{}
+
+	err := conn.DescribeListenersPages(&elbv2.DescribeListenersInput{
+		LoadBalancerArn: aws.String(d.Id()),
+	}, func(page *elbv2.DescribeListenersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, listener := range page.Listeners {
+			listenerMap := map[string]interface{}{
+				"arn":             aws.StringValue(listener.ListenerArn),
+				"port":            aws.Int64Value(listener.Port),
+				"protocol":        aws.StringValue(listener.Protocol),
+				"ssl_policy":      aws.StringValue(listener.SslPolicy),
+				"certificate_arn": "",
+			}
+
+			if len(listener.Certificates) > 0 {
+				listenerMap["certificate_arn"] = aws.StringValue(listener.Certificates[0].CertificateArn)
+			}
+
+			if len(listener.DefaultActions) > 0 {
+				listenerMap["default_action_type"] = aws.StringValue(listener.DefaultActions[0].Type)
+			}
+
+			listeners = append(listeners, listenerMap)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error retrieving LB Listeners: %w", err)
+	}
+
+	if err := d.Set("listeners", listeners); err != nil {
+		return fmt.Errorf("error setting listeners: %w", err)
+	}
+
+	return nil
+}
+
+// dataSourceAwsLbSetTargetGroups populates the computed target_groups[]
+// block, optionally including per-target health when include_target_health
+// is set.
+func dataSourceAwsLbSetTargetGroups(d *schema.ResourceData, conn *elbv2.ELBV2) error {
+	includeTargetHealth := d.Get("include_target_health").(bool)
+
+	var results []*elbv2.TargetGroup
+
+	err := conn.DescribeTargetGroupsPages(&elbv2.DescribeTargetGroupsInput{
+		LoadBalancerArn: aws.String(d.Id()),
+	}, func(page *elbv2.DescribeTargetGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		results = append(results, page.TargetGroups...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error retrieving LB Target Groups: %w", err)
+	}
+
+	targetGroups := make([]interface{}, 0, len(results))
+
+	for _, targetGroup := range results {
+		targetGroupMap := map[string]interface{}{
+			"arn":                   aws.StringValue(targetGroup.TargetGroupArn),
+			"name":                  aws.StringValue(targetGroup.TargetGroupName),
+			"port":                  aws.Int64Value(targetGroup.Port),
+			"protocol":              aws.StringValue(targetGroup.Protocol),
+			"target_type":           aws.StringValue(targetGroup.TargetType),
+			"health_check_protocol": aws.StringValue(targetGroup.HealthCheckProtocol),
+			"health_check_port":     aws.StringValue(targetGroup.HealthCheckPort),
+			"health_check_path":     aws.StringValue(targetGroup.HealthCheckPath),
+		}
+
+		if includeTargetHealth {
+			targets, err := dataSourceAwsLbTargetHealth(conn, targetGroup.TargetGroupArn)
+			if err != nil {
+				return err
+			}
+
+			targetGroupMap["targets"] = targets
+		}
+
+		targetGroups = append(targetGroups, targetGroupMap)
+	}
+
+	if err := d.Set("target_groups", targetGroups); err != nil {
+		return fmt.Errorf("error setting target_groups: %w", err)
+	}
+
 	return nil
 }
+
+// dataSourceAwsLbTargetHealth describes the registered targets for a single
+// target group and flattens them for the targets[] nested block.
+func dataSourceAwsLbTargetHealth(conn *elbv2.ELBV2, targetGroupArn *string) ([]interface{}, error) {
+	output, err := conn.DescribeTargetHealth(&elbv2.DescribeTargetHealthInput{
+		TargetGroupArn: targetGroupArn,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving Target Health for Target Group (%s): %w", aws.StringValue(targetGroupArn), err)
+	}
+
+	targets := make([]interface{}, 0, len(output.TargetHealthDescriptions))
+
+	for _, desc := range output.TargetHealthDescriptions {
+		targetMap := map[string]interface{}{
+			"id":   "",
+			"port": 0,
+			"az":   "",
+		}
+
+		if desc.Target != nil {
+			targetMap["id"] = aws.StringValue(desc.Target.Id)
+			targetMap["port"] = aws.Int64Value(desc.Target.Port)
+			targetMap["az"] = aws.StringValue(desc.Target.AvailabilityZone)
+		}
+
+		if desc.TargetHealth != nil {
+			targetMap["state"] = aws.StringValue(desc.TargetHealth.State)
+			targetMap["reason"] = aws.StringValue(desc.TargetHealth.Reason)
+		}
+
+		targets = append(targets, targetMap)
+	}
+
+	return targets, nil
+}
+
+// dataSourceAwsLbSetWebAcl looks up the WAFv2 web ACL currently associated
+// with the load balancer, if any, and sets the web_acl_* computed attributes.
+func dataSourceAwsLbSetWebAcl(d *schema.ResourceData, meta interface{}, lb *elbv2.LoadBalancer) error {
+	// Only ALBs can be associated with a WAFv2 web ACL. NLBs and GWLBs are
+	// not a supported WAFv2 resource type and GetWebACLForResource
+	// returns an API error for them.
+	if aws.StringValue(lb.Type) != elbv2.LoadBalancerTypeEnumApplication {
+		d.Set("web_acl_arn", "")
+		d.Set("web_acl_id", "")
+		d.Set("web_acl_name", "")
+
+		return nil
+	}
+
+	conn := meta.(*AWSClient).wafv2conn
+
+	output, err := conn.GetWebACLForResource(&wafv2.GetWebACLForResourceInput{
+		ResourceArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, wafv2.ErrCodeWAFUnavailableEntityException) {
+		d.Set("web_acl_arn", "")
+		d.Set("web_acl_id", "")
+		d.Set("web_acl_name", "")
+
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error getting WAFv2 Web ACL for LB (%s): %w", d.Id(), err)
+	}
+
+	if output == nil || output.WebACL == nil {
+		d.Set("web_acl_arn", "")
+		d.Set("web_acl_id", "")
+		d.Set("web_acl_name", "")
+
+		return nil
+	}
+
+	d.Set("web_acl_arn", output.WebACL.ARN)
+	d.Set("web_acl_id", output.WebACL.Id)
+	d.Set("web_acl_name", output.WebACL.Name)
+
+	return nil
+}
+
+// dataSourceAwsLbSetShieldProtection looks up the Shield Advanced protection
+// covering the load balancer, if the account is subscribed to Shield
+// Advanced. Shield Advanced supports both ALBs and NLBs; Gateway Load
+// Balancers are not a protectable Shield resource type.
+func dataSourceAwsLbSetShieldProtection(d *schema.ResourceData, meta interface{}, lb *elbv2.LoadBalancer) error {
+	if aws.StringValue(lb.Type) == elbv2.LoadBalancerTypeEnumGateway {
+		return d.Set("shield_protection", []interface{}{})
+	}
+
+	conn := meta.(*AWSClient).shieldconn
+
+	output, err := conn.ListProtections(&shield.ListProtectionsInput{
+		Filters: &shield.ListProtectionsRequestFilters{
+			ResourceArns: aws.StringSlice([]string{d.Id()}),
+		},
+	})
+
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) || tfawserr.ErrCodeEquals(err, shield.ErrCodeInvalidOperationException) {
+		return d.Set("shield_protection", []interface{}{})
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Shield protections for LB (%s): %w", d.Id(), err)
+	}
+
+	if len(output.Protections) == 0 {
+		protectionMap := map[string]interface{}{
+			"enabled":        false,
+			"protection_id":  "",
+			"protection_arn": "",
+		}
+
+		return d.Set("shield_protection", []interface{}{protectionMap})
+	}
+
+	protection := output.Protections[0]
+
+	protectionMap := map[string]interface{}{
+		"enabled":        true,
+		"protection_id":  aws.StringValue(protection.Id),
+		"protection_arn": aws.StringValue(protection.ProtectionArn),
+	}
+
+	return d.Set("shield_protection", []interface{}{protectionMap})
+}
@@ -0,0 +1,118 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestExpandLbDescribeFilters(t *testing.T) {
+	set := lbFilterSchema().ZeroValue().(*schema.Set)
+	set.Add(map[string]interface{}{
+		"name":   "vpc_id",
+		"values": schema.NewSet(schema.HashString, []interface{}{"vpc-123"}),
+	})
+
+	filters := expandLbDescribeFilters(set)
+
+	if len(filters) != 1 {
+		t.Fatalf("expected 1 filter, got %d", len(filters))
+	}
+
+	if filters[0].Name != "vpc_id" {
+		t.Errorf("expected filter name vpc_id, got %s", filters[0].Name)
+	}
+
+	if len(filters[0].Values) != 1 || filters[0].Values[0] != "vpc-123" {
+		t.Errorf("unexpected filter values: %#v", filters[0].Values)
+	}
+}
+
+func TestLbMatchesFilters(t *testing.T) {
+	lb := &elbv2.LoadBalancer{
+		VpcId:         aws.String("vpc-123"),
+		Scheme:        aws.String("internal"),
+		Type:          aws.String(elbv2.LoadBalancerTypeEnumApplication),
+		IpAddressType: aws.String("ipv4"),
+		State: &elbv2.LoadBalancerState{
+			Code: aws.String("active"),
+		},
+	}
+
+	testCases := []struct {
+		name    string
+		filters []*lbDescribeFilter
+		matches bool
+		wantErr bool
+	}{
+		{
+			name: "single matching filter",
+			filters: []*lbDescribeFilter{
+				{Name: "vpc_id", Values: []string{"vpc-123"}},
+			},
+			matches: true,
+		},
+		{
+			name: "single non-matching filter",
+			filters: []*lbDescribeFilter{
+				{Name: "vpc_id", Values: []string{"vpc-456"}},
+			},
+			matches: false,
+		},
+		{
+			name: "matches any of multiple values",
+			filters: []*lbDescribeFilter{
+				{Name: "scheme", Values: []string{"internet-facing", "internal"}},
+			},
+			matches: true,
+		},
+		{
+			name: "multiple filters must all match",
+			filters: []*lbDescribeFilter{
+				{Name: "scheme", Values: []string{"internal"}},
+				{Name: "type", Values: []string{elbv2.LoadBalancerTypeEnumApplication}},
+				{Name: "ip_address_type", Values: []string{"ipv4"}},
+				{Name: "state.code", Values: []string{"active"}},
+			},
+			matches: true,
+		},
+		{
+			name: "one non-matching filter fails the whole set",
+			filters: []*lbDescribeFilter{
+				{Name: "scheme", Values: []string{"internal"}},
+				{Name: "type", Values: []string{elbv2.LoadBalancerTypeEnumNetwork}},
+			},
+			matches: false,
+		},
+		{
+			name: "unsupported filter name returns an error",
+			filters: []*lbDescribeFilter{
+				{Name: "vpc-id", Values: []string{"vpc-123"}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			matches, err := lbMatchesFilters(lb, tc.filters)
+
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected an error, got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if matches != tc.matches {
+				t.Errorf("expected matches=%t, got %t", tc.matches, matches)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func dataSourceAwsLbs() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsLbsRead,
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"filter": lbFilterSchema(),
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func dataSourceAwsLbsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elbv2conn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	tagsToMatch := keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	var results []*elbv2.LoadBalancer
+
+	err := conn.DescribeLoadBalancersPages(&elbv2.DescribeLoadBalancersInput{}, func(page *elbv2.DescribeLoadBalancersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		results = append(results, page.LoadBalancers...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error retrieving LBs: %w", err)
+	}
+
+	if filters := expandLbDescribeFilters(d.Get("filter").(*schema.Set)); len(filters) > 0 {
+		var filtered []*elbv2.LoadBalancer
+
+		for _, loadBalancer := range results {
+			matches, err := lbMatchesFilters(loadBalancer, filters)
+			if err != nil {
+				return err
+			}
+
+			if matches {
+				filtered = append(filtered, loadBalancer)
+			}
+		}
+
+		results = filtered
+	}
+
+	if len(tagsToMatch) > 0 {
+		var loadBalancers []*elbv2.LoadBalancer
+
+		for _, loadBalancer := range results {
+			arn := aws.StringValue(loadBalancer.LoadBalancerArn)
+			tags, err := keyvaluetags.Elbv2ListTags(conn, arn)
+
+			if tfawserr.ErrCodeEquals(err, elbv2.ErrCodeLoadBalancerNotFoundException) {
+				continue
+			}
+
+			if err != nil {
+				return fmt.Errorf("error listing tags for (%s): %w", arn, err)
+			}
+
+			if !tags.ContainsAll(tagsToMatch) {
+				continue
+			}
+
+			loadBalancers = append(loadBalancers, loadBalancer)
+		}
+
+		results = loadBalancers
+	}
+
+	arns := make([]string, 0, len(results))
+	for _, loadBalancer := range results {
+		arns = append(arns, aws.StringValue(loadBalancer.LoadBalancerArn))
+	}
+
+	d.SetId(meta.(*AWSClient).region)
+
+	if err := d.Set("arns", arns); err != nil {
+		return fmt.Errorf("error setting arns: %w", err)
+	}
+
+	return nil
+}